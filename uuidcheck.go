@@ -3,49 +3,131 @@
 package uuidcheck
 
 import (
+	"crypto/md5"  //nolint:gosec // MD5 is required by RFC 4122 for UUIDv3, not used for security.
+	"crypto/sha1" //nolint:gosec // SHA-1 is required by RFC 4122 for UUIDv5, not used for security.
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 )
 
-// IsValidUUID checks if the provided string is a syntactically valid UUID according to RFC 4122 format.
-//
-// A valid UUID is a 36-character string in the form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", where
-// each 'x' is a valid hexadecimal character (0-9, a-f, A-F), and hyphens are strictly placed at
-// positions 8, 13, 18, and 23.
+const (
+	lenHyphenated = 36 // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	lenHyphenless = 32 // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+	lenBraced     = 38 // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+	lenURN        = 45 // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+
+	urnPrefix = "urn:uuid:"
+)
+
+// IsValidUUID checks if the provided string is a syntactically valid UUID in any of the common
+// textual encodings: the 36-character hyphenated form, the 32-character hyphenless form, the
+// brace-wrapped form ("{...}"), and the "urn:uuid:" form.
 //
 // For example:
 //   - "f47ac10b-58cc-0372-8567-0e02b2c3d479" is a valid UUID
-//   - "f47ac10b58cc037285670e02b2c3d479" (no hyphens) is not
+//   - "f47ac10b58cc037285670e02b2c3d479" (hyphenless) is also valid
+//   - "{f47ac10b-58cc-0372-8567-0e02b2c3d479}" (braced) is also valid
+//   - "urn:uuid:f47ac10b-58cc-0372-8567-0e02b2c3d479" (URN) is also valid
 //   - "f47ac10b-58cc-0372-8567-0e02b2c3d47z" (invalid hex 'z') is not
 func IsValidUUID(uuid string) bool {
-	// UUID must be exactly 36 characters: 8-4-4-4-12
-	if len(uuid) != 36 {
-		return false
+	return Validate(uuid) == nil
+}
+
+// Validate checks that uuid is a syntactically valid UUID in any of the forms accepted by
+// IsValidUUID, returning an error identifying which check failed instead of a bare bool.
+//
+// This lets callers surface useful parse diagnostics (e.g. "bad urn prefix", "unbalanced braces",
+// "bad hyphen position", or the offending byte and index) rather than just a boolean result.
+func Validate(uuid string) error {
+	_, err := canonicalize(uuid)
+	return err
+}
+
+// canonicalize normalizes uuid to the canonical 36-character hyphenated form and validates it,
+// so the version/variant/timestamp accessors below can all share one parsing path.
+func canonicalize(uuid string) (string, error) {
+	norm, err := normalizeUUID(uuid)
+	if err != nil {
+		return "", err
+	}
+	if err := validateHyphenated(norm); err != nil {
+		return "", err
 	}
+	return norm, nil
+}
 
-	// Set of predefined hyphen positions (8, 13, 18, 23)
-	hyphenPositions := map[int]struct{}{
-		8: {}, 13: {}, 18: {}, 23: {},
+// normalizeUUID strips the wrapper off a braced or URN-prefixed UUID and reshapes a hyphenless
+// UUID into the canonical 36-character hyphenated form, so callers can run a single hyphenated
+// or hex check afterward. It dispatches purely on length.
+func normalizeUUID(uuid string) (string, error) {
+	switch len(uuid) {
+	case lenHyphenated:
+		return uuid, nil
+	case lenHyphenless:
+		if err := validateHex(uuid); err != nil {
+			return "", err
+		}
+		return uuid[0:8] + "-" + uuid[8:12] + "-" + uuid[12:16] + "-" + uuid[16:20] + "-" + uuid[20:32], nil
+	case lenBraced:
+		if uuid[0] != '{' || uuid[lenBraced-1] != '}' {
+			return "", fmt.Errorf("uuidcheck: unbalanced braces in %q", uuid)
+		}
+		return normalizeUUID(uuid[1 : lenBraced-1])
+	case lenURN:
+		if !strings.EqualFold(uuid[:len(urnPrefix)], urnPrefix) {
+			return "", fmt.Errorf("uuidcheck: bad urn prefix in %q", uuid)
+		}
+		return normalizeUUID(uuid[len(urnPrefix):])
+	default:
+		return "", fmt.Errorf("uuidcheck: invalid UUID length %d", len(uuid))
 	}
+}
 
-	for i, c := range uuid {
-		// If the character is at a hyphen position, it must be '-'
-		if _, isHyphen := hyphenPositions[i]; isHyphen {
+// validateHyphenated checks that uuid is exactly 36 characters long, with hex digits in every
+// position except 8, 13, 18, and 23, which must hold hyphens.
+//
+// It walks uuid by byte index rather than ranging over it as runes, and tests each byte against
+// hexTable instead of building a lookup structure per call, so a call costs one indexed load per
+// byte and no allocations.
+func validateHyphenated(uuid string) error {
+	if len(uuid) != lenHyphenated {
+		return fmt.Errorf("uuidcheck: invalid UUID length %d", len(uuid))
+	}
+
+	for i := 0; i < lenHyphenated; i++ {
+		c := uuid[i]
+		if isHyphenPosition(i) {
 			if c != '-' {
-				return false
+				return fmt.Errorf("uuidcheck: bad hyphen position at index %d", i)
 			}
 			continue
 		}
 
-		// Otherwise, it must be a hexadecimal digit (0-9, a-f, A-F)
-		if !isHexadecimal(c) {
-			return false
+		if !hexTable[c] {
+			return fmt.Errorf("uuidcheck: non-hex byte %q at index %d", c, i)
 		}
 	}
 
-	return true
+	return nil
+}
+
+// validateHex checks that every byte of uuid is a valid hexadecimal digit.
+func validateHex(uuid string) error {
+	for i := 0; i < len(uuid); i++ {
+		if !hexTable[uuid[i]] {
+			return fmt.Errorf("uuidcheck: non-hex byte %q at index %d", uuid[i], i)
+		}
+	}
+	return nil
+}
+
+// isHyphenPosition reports whether i is one of the hyphen positions (8, 13, 18, 23) in a
+// canonical 36-character hyphenated UUID.
+func isHyphenPosition(i int) bool {
+	return i == 8 || i == 13 || i == 18 || i == 23
 }
 
 // IsUUIDv7 checks if the given UUID is a UUID version 7.
@@ -57,19 +139,109 @@ func IsValidUUID(uuid string) bool {
 // For example:
 //   - "01939c00-282d-7f2f-9cc2-887dc7b40629" should return true
 //   - "f47ac10b-58cc-0372-8567-0e02b2c3d479" (which might be version 3) will return false
+//   - "abcd" (too short to hold a version nibble) returns false
 func IsUUIDv7(uuid string) bool {
-	// The version nibble is at uuid[14].
+	// The version nibble is at uuid[14] in the hyphenated form only; hyphenless, braced, and URN
+	// forms are not recognized. Bail out on inputs too short to hold it.
+	if len(uuid) < 15 {
+		return false
+	}
 	return uuid[14] == '7'
 }
 
+// IsUUIDv3 checks if the given UUID is a UUID version 3 (name-based, MD5).
+//
+// This function assumes the input is a UUID in the 36-character hyphenated form: it reads the
+// version nibble at index 14, so the hyphenless, braced, and URN forms accepted by IsValidUUID are
+// not recognized. See VerifyNameBasedUUID to confirm that a v3 UUID was actually derived from a
+// given namespace and name.
+func IsUUIDv3(uuid string) bool {
+	if len(uuid) < 15 {
+		return false
+	}
+	return uuid[14] == '3'
+}
+
+// IsUUIDv5 checks if the given UUID is a UUID version 5 (name-based, SHA-1).
+//
+// This function assumes the input is a UUID in the 36-character hyphenated form: it reads the
+// version nibble at index 14, so the hyphenless, braced, and URN forms accepted by IsValidUUID are
+// not recognized. See VerifyNameBasedUUID to confirm that a v5 UUID was actually derived from a
+// given namespace and name.
+func IsUUIDv5(uuid string) bool {
+	if len(uuid) < 15 {
+		return false
+	}
+	return uuid[14] == '5'
+}
+
+// RFC 4122 Appendix C namespace IDs, for use with VerifyNameBasedUUID.
+var (
+	NamespaceDNS  = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceURL  = [16]byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceOID  = [16]byte{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceX500 = [16]byte{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+// VerifyNameBasedUUID reports whether uuid is the name-based UUID (version 3 or 5, per RFC 4122
+// §4.3) derived from the given namespace and name. It recomputes hash = H(namespace || name),
+// using MD5 for a v3 uuid or SHA-1 for a v5 uuid, sets the version and variant bits on the
+// resulting hash as the algorithm requires, and compares the result against uuid in constant time.
+//
+// It returns an error if uuid fails validation or is not a v3 or v5 UUID.
+func VerifyNameBasedUUID(uuid string, namespace [16]byte, name []byte) (bool, error) {
+	canonical, err := canonicalize(uuid)
+	if err != nil {
+		return false, err
+	}
+
+	version, err := Version(canonical)
+	if err != nil {
+		return false, err
+	}
+	if version != 3 && version != 5 {
+		return false, fmt.Errorf("uuidcheck: not a name-based UUID (version %d)", version)
+	}
+
+	var sum []byte
+	switch version {
+	case 3:
+		h := md5.Sum(append(namespace[:], name...))
+		sum = h[:]
+	case 5:
+		h := sha1.Sum(append(namespace[:], name...))
+		sum = h[:16]
+	}
+
+	sum[6] = (sum[6] & 0x0F) | byte(version<<4) // set the version nibble
+	sum[8] = (sum[8] & 0x3F) | 0x80             // set the variant bits to RFC4122 (10xx)
+
+	decoded, err := decodeUUIDBytes(canonical)
+	if err != nil {
+		return false, err
+	}
+
+	return subtle.ConstantTimeCompare(sum, decoded[:]) == 1, nil
+}
+
+// decodeUUIDBytes hex-decodes a canonical 36-character hyphenated UUID into its raw 16 bytes.
+func decodeUUIDBytes(canonical string) ([16]byte, error) {
+	var out [16]byte
+	hexStr := canonical[0:8] + canonical[9:13] + canonical[14:18] + canonical[19:23] + canonical[24:36]
+	b, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return out, fmt.Errorf("uuidcheck: failed to decode UUID bytes: %w", err)
+	}
+	copy(out[:], b)
+	return out, nil
+}
+
 // UUIDv7ToTimestamp extracts the Unix timestamp (in milliseconds since epoch) embedded in a UUIDv7
 // and returns it as a time.Time in UTC.
 //
-// UUIDv7 encodes a 60-bit Unix timestamp in the first 60 bits of the UUID. This function assumes a
-// correctly formatted and valid UUIDv7 string:
-//   - Exactly 36 characters: 8-4-4-4-12 (with hyphens)
-//   - Hex digits in all non-hyphen positions
-//   - The version nibble in time_hi_and_version set to 7
+// UUIDv7 encodes a 48-bit Unix millisecond timestamp in the first 48 bits of the UUID. uuid may be given in
+// any of the forms accepted by IsValidUUID (hyphenated, hyphenless, braced, or URN); it is
+// normalized to the canonical hyphenated form before parsing.
 //
 // It returns an error if parsing fails or if the UUID does not contain a valid timestamp.
 //
@@ -80,10 +252,130 @@ func IsUUIDv7(uuid string) bool {
 // Note: The extracted timestamp corresponds to when the UUID was generated (or intended to be generated),
 // providing a sortable and roughly chronological ordering of UUIDs.
 func UUIDv7ToTimestamp(uuid string) (time.Time, error) {
+	canonical, err := canonicalize(uuid)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return v7Timestamp(canonical)
+}
+
+// Version returns the value (0-15) of the version nibble of uuid, which may be given in any of
+// the forms accepted by IsValidUUID. RFC-defined versions are 1-8; other values are returned as
+// they are, without an error.
+func Version(uuid string) (int, error) {
+	canonical, err := canonicalize(uuid)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := strconv.ParseUint(canonical[14:15], 16, 8)
+	if err != nil {
+		return 0, fmt.Errorf("uuidcheck: failed to parse version nibble: %w", err)
+	}
+	return int(v), nil
+}
+
+// Variant identifies the layout of a UUID's variant field, per RFC 4122 section 4.1.1.
+type Variant int
+
+const (
+	// VariantNCS identifies UUIDs reserved for NCS backward compatibility.
+	VariantNCS Variant = iota
+	// VariantRFC4122 identifies UUIDs laid out per RFC 4122 (the common case).
+	VariantRFC4122
+	// VariantMicrosoft identifies UUIDs reserved for Microsoft backward compatibility.
+	VariantMicrosoft
+	// VariantFuture identifies UUIDs reserved for future definition.
+	VariantFuture
+)
+
+// String returns a human-readable name for v.
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC4122:
+		return "RFC4122"
+	case VariantMicrosoft:
+		return "Microsoft"
+	case VariantFuture:
+		return "Future"
+	default:
+		return "Unknown"
+	}
+}
+
+// VariantOf returns the variant encoded in the top bits of uuid's clock_seq_hi byte (uuid[19:21]),
+// which may be given in any of the forms accepted by IsValidUUID.
+func VariantOf(uuid string) (Variant, error) {
+	canonical, err := canonicalize(uuid)
+	if err != nil {
+		return 0, err
+	}
+
+	nibble, err := strconv.ParseUint(canonical[19:20], 16, 8)
+	if err != nil {
+		return 0, fmt.Errorf("uuidcheck: failed to parse variant nibble: %w", err)
+	}
+
+	switch {
+	case nibble < 0x8:
+		return VariantNCS, nil
+	case nibble < 0xC:
+		return VariantRFC4122, nil
+	case nibble < 0xE:
+		return VariantMicrosoft, nil
+	default:
+		return VariantFuture, nil
+	}
+}
+
+// gregorianOffset100ns is the number of 100-nanosecond intervals between the Gregorian epoch
+// (1582-10-15 00:00:00 UTC), used by UUID versions 1 and 6, and the Unix epoch.
+const gregorianOffset100ns = 122192928000000000
+
+// gregorianTicksToTime converts a 60-bit count of 100-nanosecond intervals since the Gregorian
+// epoch, as embedded in a UUIDv1 or UUIDv6, into a time.Time in UTC.
+//
+// The intermediate value is split into seconds and a nanosecond remainder before conversion,
+// since the full count does not fit in an int64 once expressed in nanoseconds.
+func gregorianTicksToTime(ticks uint64) time.Time {
+	const ticksPerSecond = 10_000_000 // 100ns intervals per second
+	unixTicks := int64(ticks) - gregorianOffset100ns
+	return time.Unix(unixTicks/ticksPerSecond, (unixTicks%ticksPerSecond)*100).UTC()
+}
+
+// v1Timestamp extracts the Gregorian-epoch timestamp embedded in a canonical UUIDv1 string.
+//
+// The 60-bit timestamp is split across time_low (uuid[0:8]), time_mid (uuid[9:13]), and the low
+// 12 bits of time_hi_and_version (uuid[15:18]); reassembling them in reverse order (time_hi,
+// then time_mid, then time_low, high to low) yields the tick count.
+func v1Timestamp(uuid string) (time.Time, error) {
 	parts := strings.Split(uuid, "-")
-	if len(parts) < 2 {
-		return time.Time{}, fmt.Errorf("invalid UUID format")
+	ticks, err := strconv.ParseUint(parts[2][1:]+parts[1]+parts[0], 16, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("uuidcheck: failed to parse v1 timestamp: %w", err)
 	}
+	return gregorianTicksToTime(ticks), nil
+}
+
+// v6Timestamp extracts the Gregorian-epoch timestamp embedded in a canonical UUIDv6 string.
+//
+// UUIDv6 rearranges the v1 fields into monotonic order, so time_high (uuid[0:8]), time_mid
+// (uuid[9:13]), and the low 12 bits of time_low_and_version (uuid[15:18]) concatenate directly
+// into the 60-bit tick count.
+func v6Timestamp(uuid string) (time.Time, error) {
+	parts := strings.Split(uuid, "-")
+	ticks, err := strconv.ParseUint(parts[0]+parts[1]+parts[2][1:], 16, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("uuidcheck: failed to parse v6 timestamp: %w", err)
+	}
+	return gregorianTicksToTime(ticks), nil
+}
+
+// v7Timestamp extracts the Unix-epoch millisecond timestamp embedded in a canonical UUIDv7 string.
+func v7Timestamp(uuid string) (time.Time, error) {
+	parts := strings.Split(uuid, "-")
 
 	// Concatenate parts[0] (8 hex chars) and the first 4 hex chars of parts[1], providing 12 hex chars total.
 	highBitsHex := parts[0] + parts[1][:4]
@@ -95,11 +387,110 @@ func UUIDv7ToTimestamp(uuid string) (time.Time, error) {
 	}
 
 	// Convert milliseconds since Unix epoch to time.Time in UTC.
-	t := time.UnixMilli(int64(timestamp)).UTC()
-	return t, nil
+	return time.UnixMilli(int64(timestamp)).UTC(), nil
+}
+
+// Timestamp returns the time.Time embedded in uuid, dispatching on its version. It supports
+// UUIDv1, UUIDv6, and UUIDv7, the three RFC-defined versions that embed a timestamp; any other
+// version returns an error.
+func Timestamp(uuid string) (time.Time, error) {
+	canonical, err := canonicalize(uuid)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	version, err := Version(canonical)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch version {
+	case 1:
+		return v1Timestamp(canonical)
+	case 6:
+		return v6Timestamp(canonical)
+	case 7:
+		return v7Timestamp(canonical)
+	default:
+		return time.Time{}, fmt.Errorf("uuidcheck: timestamps are not defined for UUID version %d", version)
+	}
 }
 
-// isHexadecimal checks if a character is a valid hexadecimal character (0-9, a-f, A-F).
-func isHexadecimal(c rune) bool {
-	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+// UUIDv7Fields extracts the raw fields packed into a UUIDv7, per RFC 9562: tsMs is the 48-bit
+// Unix millisecond timestamp, randA is the 12 bits immediately following the version nibble, and
+// randB is the 62 bits following the variant bits.
+//
+// randA and randB are generator-specific: some implementations fill them with pure randomness,
+// others use randA as a sub-millisecond counter or randB's leading bits for monotonicity. Callers
+// implementing or verifying such schemes can read the raw bits here instead of re-parsing uuid.
+func UUIDv7Fields(uuid string) (tsMs uint64, randA uint16, randB uint64, err error) {
+	canonical, err := canonicalize(uuid)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if version, verr := Version(canonical); verr != nil || version != 7 {
+		return 0, 0, 0, fmt.Errorf("uuidcheck: not a UUIDv7 (version %d)", version)
+	}
+
+	parts := strings.Split(canonical, "-")
+
+	tsMs, err = strconv.ParseUint(parts[0]+parts[1][:4], 16, 64)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("uuidcheck: failed to parse timestamp: %w", err)
+	}
+
+	// parts[2][1:] is the 12-bit rand_a field, following the version nibble.
+	ra, err := strconv.ParseUint(parts[2][1:], 16, 16)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("uuidcheck: failed to parse rand_a: %w", err)
+	}
+	randA = uint16(ra)
+
+	// parts[3] holds the 2-bit variant in its top bits, followed by 14 bits of rand_b.
+	p3, err := strconv.ParseUint(parts[3], 16, 16)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("uuidcheck: failed to parse rand_b: %w", err)
+	}
+	rbLow, err := strconv.ParseUint(parts[4], 16, 64)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("uuidcheck: failed to parse rand_b: %w", err)
+	}
+	randB = (p3&0x3FFF)<<48 | rbLow
+
+	return tsMs, randA, randB, nil
+}
+
+// UUIDv7ToTimestampPrecise extracts the millisecond timestamp embedded in a UUIDv7, as
+// UUIDv7ToTimestamp does, and adds a best-effort sub-millisecond component derived from rand_a by
+// treating its 12 bits as a fraction of a millisecond (ns = rand_a * 1_000_000 / 4096).
+//
+// This sub-millisecond interpretation is generator-specific: it is only meaningful for producers
+// that fill rand_a with extra timestamp precision rather than pure randomness. See UUIDv7Fields
+// for direct access to the raw fields.
+func UUIDv7ToTimestampPrecise(uuid string) (time.Time, error) {
+	tsMs, randA, _, err := UUIDv7Fields(uuid)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	subMs := time.Duration(uint64(randA)*1_000_000/4096) * time.Nanosecond
+	return time.UnixMilli(int64(tsMs)).UTC().Add(subMs), nil
+}
+
+// hexTable reports, for each possible byte value, whether it is a valid hexadecimal character
+// (0-9, a-f, A-F). It is populated once in init so validation costs a single indexed load per
+// byte instead of a range of comparisons.
+var hexTable [256]bool
+
+func init() {
+	for c := '0'; c <= '9'; c++ {
+		hexTable[c] = true
+	}
+	for c := 'a'; c <= 'f'; c++ {
+		hexTable[c] = true
+	}
+	for c := 'A'; c <= 'F'; c++ {
+		hexTable[c] = true
+	}
 }
@@ -47,12 +47,39 @@ func TestIsValidUUID(t *testing.T) {
 			expect: false,
 		},
 
-		// Invalid Hyphens
+		// Alternate textual encodings
 		{
-			name:   "No hyphens at all",
+			name:   "Hyphenless valid UUID",
 			input:  "f47ac10b58cc037285670e02b2c3d479",
+			expect: true,
+		},
+		{
+			name:   "Braced valid UUID",
+			input:  "{f47ac10b-58cc-0372-8567-0e02b2c3d479}",
+			expect: true,
+		},
+		{
+			name:   "URN valid UUID",
+			input:  "urn:uuid:f47ac10b-58cc-0372-8567-0e02b2c3d479",
+			expect: true,
+		},
+		{
+			name:   "URN prefix wrong case still accepted",
+			input:  "URN:UUID:f47ac10b-58cc-0372-8567-0e02b2c3d479",
+			expect: true,
+		},
+		{
+			name:   "Braced UUID missing closing brace",
+			input:  "{f47ac10b-58cc-0372-8567-0e02b2c3d479",
 			expect: false,
 		},
+		{
+			name:   "Hyphenless UUID with non-hex character",
+			input:  "f47ac10b58cc037285670e02b2c3d47z",
+			expect: false,
+		},
+
+		// Invalid Hyphens
 		{
 			name:   "Hyphens in wrong places",
 			input:  "f47ac10b-58cc0-372-8567-0e02b2c3d479",
@@ -109,88 +136,111 @@ func TestIsValidUUID(t *testing.T) {
 	}
 }
 
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantErr   bool
+		errSubstr string
+	}{
+		{
+			name:    "Valid hyphenated UUID",
+			input:   "f47ac10b-58cc-0372-8567-0e02b2c3d479",
+			wantErr: false,
+		},
+		{
+			name:      "Bad urn prefix",
+			input:     "urn:uiid:f47ac10b-58cc-0372-8567-0e02b2c3d479",
+			wantErr:   true,
+			errSubstr: "bad urn prefix",
+		},
+		{
+			name:      "Unbalanced braces",
+			input:     "{f47ac10b-58cc-0372-8567-0e02b2c3d479)",
+			wantErr:   true,
+			errSubstr: "unbalanced braces",
+		},
+		{
+			name:      "Bad hyphen position",
+			input:     "f47ac10ba58cc-0372-8567-0e02b2c3d479",
+			wantErr:   true,
+			errSubstr: "bad hyphen position",
+		},
+		{
+			name:      "Non-hex byte reported with index",
+			input:     "f47ac10b-58cc-0372-8567-0e02b2c3d47z",
+			wantErr:   true,
+			errSubstr: "non-hex byte",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uuidcheck.Validate(tt.input)
+			if tt.wantErr && err == nil {
+				t.Fatalf("Validate(%q) = nil; want error containing %q", tt.input, tt.errSubstr)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("Validate(%q) = %v; want nil", tt.input, err)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.errSubstr) {
+				t.Errorf("Validate(%q) error = %q; want substring %q", tt.input, err.Error(), tt.errSubstr)
+			}
+		})
+	}
+}
+
 func TestIsUUIDv7(t *testing.T) {
 	tests := []struct {
-		name        string
-		input       string
-		expectV7    bool
-		expectPanic bool
+		name     string
+		input    string
+		expectV7 bool
 	}{
 		{
-			name:        "Short string",
-			input:       "abcd",
-			expectV7:    false,
-			expectPanic: true, // accessing uuid[14] should panic
+			name:     "Short string",
+			input:    "abcd",
+			expectV7: false, // too short to hold a version nibble; must not panic
 		},
 		{
-			name:        "Non-UUID string but long enough",
-			input:       "abcdefghijklmnopqrstuvxyz0123456789abcd", // 36 chars but no hyphens
-			expectV7:    false,
-			expectPanic: false,
+			name:     "Non-UUID string but long enough",
+			input:    "abcdefghijklmnopqrstuvxyz0123456789abcd", // 39 chars but no hyphens
+			expectV7: false,
 		},
 		{
-			name:        "Version 7 UUID all lowercase",
-			input:       "00000000-0000-7000-0000-000000000000",
-			expectV7:    true,
-			expectPanic: false,
+			name:     "Version 7 UUID all lowercase",
+			input:    "00000000-0000-7000-0000-000000000000",
+			expectV7: true,
 		},
 		{
-			name:        "Version 7 UUID mixed case",
-			input:       "00000000-0000-7FFF-0000-000000000000",
-			expectV7:    true,
-			expectPanic: false,
+			name:     "Version 7 UUID uppercase",
+			input:    "00000000-0000-7FFF-0000-000000000000",
+			expectV7: true,
 		},
 		{
-			name:        "Version 4 UUID",
-			input:       "f47ac10b-58cc-4372-8567-0e02b2c3d479",
-			expectV7:    false,
-			expectPanic: false,
+			name:     "Version 4 UUID",
+			input:    "f47ac10b-58cc-4372-8567-0e02b2c3d479",
+			expectV7: false,
 		},
 		{
-			name:        "Version 1 UUID",
-			input:       "f47ac10b-58cc-1372-8567-0e02b2c3d479",
-			expectV7:    false,
-			expectPanic: false,
+			name:     "Version 1 UUID",
+			input:    "f47ac10b-58cc-1372-8567-0e02b2c3d479",
+			expectV7: false,
 		},
 		{
-			name:        "All zeros but version nibble not '7'",
-			input:       "00000000-0000-4000-0000-000000000000", // version nibble = '4'
-			expectV7:    false,
-			expectPanic: false,
+			name:     "All zeros but version nibble not '7'",
+			input:    "00000000-0000-4000-0000-000000000000", // version nibble = '4'
+			expectV7: false,
 		},
 		{
-			name:        "Check upper nibble when version = '7'",
-			input:       "00000000-0000-7abc-0000-000000000000",
-			expectV7:    true,
-			expectPanic: false,
+			name:     "Check upper nibble when version = '7'",
+			input:    "00000000-0000-7abc-0000-000000000000",
+			expectV7: true,
 		},
 	}
 
 	for _, tt := range tests {
-		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			var got bool
-			var didPanic bool
-
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						didPanic = true
-					}
-				}()
-
-				got = uuidcheck.IsUUIDv7(tt.input)
-			}()
-
-			if tt.expectPanic && !didPanic {
-				t.Errorf("Expected panic but got none for input: %q", tt.input)
-			}
-
-			if !tt.expectPanic && didPanic {
-				t.Errorf("Did not expect panic, but got one for input: %q", tt.input)
-			}
-
-			if !tt.expectPanic && got != tt.expectV7 {
+			if got := uuidcheck.IsUUIDv7(tt.input); got != tt.expectV7 {
 				t.Errorf("IsUUIDv7(%q) = %v; want %v", tt.input, got, tt.expectV7)
 			}
 		})
@@ -288,3 +338,369 @@ func TestUUIDv7ToTime_Success(t *testing.T) {
 // If we had a known specification for what timestamp a certain UUIDv7 should produce,
 // we could assert that exactly. For now, we're primarily testing error conditions and
 // general correctness of parsing.
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      int
+		expectErr bool
+	}{
+		{name: "Version 1", input: "f47ac10b-58cc-1372-8567-0e02b2c3d479", want: 1},
+		{name: "Version 4", input: "f47ac10b-58cc-4372-8567-0e02b2c3d479", want: 4},
+		{name: "Version 6", input: "00000000-0000-6000-8000-000000000000", want: 6},
+		{name: "Version 7", input: "00000000-0000-7000-0000-000000000000", want: 7},
+		{name: "Invalid UUID", input: "not-a-uuid", want: 0, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uuidcheck.Version(tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("Version(%q) = %d, nil; want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Version(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Version(%q) = %d; want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVariantOf(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      uuidcheck.Variant
+		expectErr bool
+	}{
+		{name: "NCS variant", input: "00000000-0000-1000-0000-000000000000", want: uuidcheck.VariantNCS},
+		{name: "RFC4122 variant", input: "00000000-0000-1000-8000-000000000000", want: uuidcheck.VariantRFC4122},
+		{name: "RFC4122 variant, upper end of range", input: "00000000-0000-1000-b000-000000000000", want: uuidcheck.VariantRFC4122},
+		{name: "Microsoft variant", input: "00000000-0000-1000-c000-000000000000", want: uuidcheck.VariantMicrosoft},
+		{name: "Future variant", input: "00000000-0000-1000-e000-000000000000", want: uuidcheck.VariantFuture},
+		{name: "Invalid UUID", input: "not-a-uuid", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uuidcheck.VariantOf(tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("VariantOf(%q) = %v, nil; want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VariantOf(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("VariantOf(%q) = %v; want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	gregorianEpoch := time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		want      time.Time
+		expectErr bool
+	}{
+		{
+			name:  "v1 at the Gregorian epoch",
+			input: "00000000-0000-1000-8000-000000000000",
+			want:  gregorianEpoch,
+		},
+		{
+			name:  "v6 at the Gregorian epoch",
+			input: "00000000-0000-6000-8000-000000000000",
+			want:  gregorianEpoch,
+		},
+		{
+			name:  "v7 at the Unix epoch",
+			input: "00000000-0000-7000-8000-000000000000",
+			want:  time.UnixMilli(0).UTC(),
+		},
+		{
+			name:      "v4 has no embedded timestamp",
+			input:     "f47ac10b-58cc-4372-8567-0e02b2c3d479",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid UUID",
+			input:     "not-a-uuid",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uuidcheck.Timestamp(tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("Timestamp(%q) = %v, nil; want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Timestamp(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Timestamp(%q) = %v; want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUUIDv7Fields(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantTsMs  uint64
+		wantRandA uint16
+		wantRandB uint64
+		expectErr bool
+		errSubstr string
+	}{
+		{
+			name:      "All zero fields",
+			input:     "00000000-0000-7000-8000-000000000000",
+			wantTsMs:  0,
+			wantRandA: 0,
+			wantRandB: 0,
+		},
+		{
+			name:      "rand_a set, rest zero",
+			input:     "00000000-0000-7800-8000-000000000000",
+			wantTsMs:  0,
+			wantRandA: 0x800,
+			wantRandB: 0,
+		},
+		{
+			name:      "Maximal rand_b, variant bits masked out",
+			input:     "00000000-0000-7000-bfff-ffffffffffff",
+			wantTsMs:  0,
+			wantRandA: 0,
+			wantRandB: 0x3FFFFFFFFFFFFFFF,
+		},
+		{
+			name:      "Not a UUIDv7",
+			input:     "f47ac10b-58cc-4372-8567-0e02b2c3d479",
+			expectErr: true,
+			errSubstr: "not a UUIDv7",
+		},
+		{
+			name:      "Invalid UUID",
+			input:     "not-a-uuid",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tsMs, randA, randB, err := uuidcheck.UUIDv7Fields(tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("UUIDv7Fields(%q) = (%d, %d, %d), nil; want an error", tt.input, tsMs, randA, randB)
+				}
+				if tt.errSubstr != "" && !strings.Contains(err.Error(), tt.errSubstr) {
+					t.Errorf("UUIDv7Fields(%q) error = %q; want substring %q", tt.input, err.Error(), tt.errSubstr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UUIDv7Fields(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if tsMs != tt.wantTsMs || randA != tt.wantRandA || randB != tt.wantRandB {
+				t.Errorf("UUIDv7Fields(%q) = (%d, %d, %d); want (%d, %d, %d)",
+					tt.input, tsMs, randA, randB, tt.wantTsMs, tt.wantRandA, tt.wantRandB)
+			}
+		})
+	}
+}
+
+func TestUUIDv7ToTimestampPrecise(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      time.Time
+		expectErr bool
+	}{
+		{
+			name:  "No sub-millisecond component",
+			input: "00000000-0000-7000-8000-000000000000",
+			want:  time.UnixMilli(0).UTC(),
+		},
+		{
+			name:  "rand_a scaled to half a millisecond",
+			input: "00000000-0000-7800-8000-000000000000",
+			want:  time.UnixMilli(0).UTC().Add(500 * time.Microsecond),
+		},
+		{
+			name:      "Not a UUIDv7",
+			input:     "f47ac10b-58cc-4372-8567-0e02b2c3d479",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uuidcheck.UUIDv7ToTimestampPrecise(tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("UUIDv7ToTimestampPrecise(%q) = %v, nil; want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UUIDv7ToTimestampPrecise(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("UUIDv7ToTimestampPrecise(%q) = %v; want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsUUIDv3AndIsUUIDv5(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expectV3 bool
+		expectV5 bool
+	}{
+		{
+			name:     "Version 3 UUID",
+			input:    "5df41881-3aed-3515-88a7-2f4a814cf09e",
+			expectV3: true,
+		},
+		{
+			name:     "Version 5 UUID",
+			input:    "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			expectV5: true,
+		},
+		{
+			name:  "Version 4 UUID",
+			input: "f47ac10b-58cc-4372-8567-0e02b2c3d479",
+		},
+		{
+			name:  "Short string",
+			input: "abcd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uuidcheck.IsUUIDv3(tt.input); got != tt.expectV3 {
+				t.Errorf("IsUUIDv3(%q) = %v; want %v", tt.input, got, tt.expectV3)
+			}
+			if got := uuidcheck.IsUUIDv5(tt.input); got != tt.expectV5 {
+				t.Errorf("IsUUIDv5(%q) = %v; want %v", tt.input, got, tt.expectV5)
+			}
+		})
+	}
+}
+
+func TestVerifyNameBasedUUID(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		namespace [16]byte
+		nameBytes string
+		want      bool
+		expectErr bool
+	}{
+		{
+			name:      "Known v3 DNS vector",
+			input:     "5df41881-3aed-3515-88a7-2f4a814cf09e",
+			namespace: uuidcheck.NamespaceDNS,
+			nameBytes: "www.example.com",
+			want:      true,
+		},
+		{
+			name:      "Known v5 DNS vector",
+			input:     "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			namespace: uuidcheck.NamespaceDNS,
+			nameBytes: "www.example.com",
+			want:      true,
+		},
+		{
+			name:      "Known v5 URL vector",
+			input:     "0a300ee9-f9e4-5697-a51a-efc7fafaba67",
+			namespace: uuidcheck.NamespaceURL,
+			nameBytes: "http://example.com/",
+			want:      true,
+		},
+		{
+			name:      "v5 vector with mismatched name",
+			input:     "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			namespace: uuidcheck.NamespaceDNS,
+			nameBytes: "www.not-example.com",
+			want:      false,
+		},
+		{
+			name:      "v5 vector with mismatched namespace",
+			input:     "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			namespace: uuidcheck.NamespaceURL,
+			nameBytes: "www.example.com",
+			want:      false,
+		},
+		{
+			name:      "Not a name-based UUID",
+			input:     "f47ac10b-58cc-4372-8567-0e02b2c3d479",
+			namespace: uuidcheck.NamespaceDNS,
+			nameBytes: "www.example.com",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid UUID",
+			input:     "not-a-uuid",
+			namespace: uuidcheck.NamespaceDNS,
+			nameBytes: "www.example.com",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uuidcheck.VerifyNameBasedUUID(tt.input, tt.namespace, []byte(tt.nameBytes))
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("VerifyNameBasedUUID(%q, ...) = %v, nil; want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyNameBasedUUID(%q, ...) returned unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("VerifyNameBasedUUID(%q, ...) = %v; want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkIsValidUUID(b *testing.B) {
+	const uuid = "f47ac10b-58cc-0372-8567-0e02b2c3d479"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		uuidcheck.IsValidUUID(uuid)
+	}
+}
+
+func BenchmarkIsUUIDv7(b *testing.B) {
+	const uuid = "01939c00-282d-7f2f-9cc2-887dc7b40629"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		uuidcheck.IsUUIDv7(uuid)
+	}
+}